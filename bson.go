@@ -0,0 +1,38 @@
+package ksuid
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// MarshalBSONValue implements bson.ValueMarshaler, encoding k as BSON binary
+// data of subtype 0x00 (generic binary) — the same way MongoDB stores its
+// own opaque fixed-length identifiers. This avoids the extra size and index
+// overhead of the 27-character string produced by Value.
+func (k KSUID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.Binary, bsoncore.AppendBinary(nil, 0x00, k.Bytes()), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (k *KSUID) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t != bsontype.Binary {
+		return fmt.Errorf("ksuid: cannot unmarshal BSON %s into KSUID", t)
+	}
+
+	subtype, b, _, ok := bsoncore.ReadBinary(data)
+	if !ok {
+		return fmt.Errorf("ksuid: malformed BSON binary value")
+	}
+	if subtype != 0x00 {
+		return fmt.Errorf("ksuid: unsupported BSON binary subtype %#x for KSUID", subtype)
+	}
+
+	id, err := FromBytes(b)
+	if err != nil {
+		return err
+	}
+	*k = id
+	return nil
+}
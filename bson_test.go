@@ -0,0 +1,20 @@
+package ksuid
+
+import "testing"
+
+func TestBSONValueRoundTrip(t *testing.T) {
+	for _, id := range []KSUID{New(), Nil} {
+		typ, data, err := id.MarshalBSONValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got KSUID
+		if err := got.UnmarshalBSONValue(typ, data); err != nil {
+			t.Fatal(err)
+		}
+		if got != id {
+			t.Fatalf("UnmarshalBSONValue() = %s, want %s", got, id)
+		}
+	}
+}
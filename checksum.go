@@ -0,0 +1,95 @@
+package ksuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math/big"
+)
+
+const checkedByteLength = byteLength + 4
+
+const checkedStringEncodedLength = 33
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+var ErrChecksumMismatch = fmt.Errorf("ksuid: checksum mismatch, the token was corrupted in transit")
+
+func (k KSUID) StringChecked() string {
+	var buf [checkedByteLength]byte
+	copy(buf[:byteLength], k[:])
+	binary.BigEndian.PutUint32(buf[byteLength:], crc32.Checksum(k[:], castagnoliTable))
+	return encodeBase62Checked(buf)
+}
+
+func ParseChecked(s string) (KSUID, error) {
+	buf, err := decodeBase62Checked(s)
+	if err != nil {
+		return Nil, err
+	}
+
+	var id KSUID
+	copy(id[:], buf[:byteLength])
+
+	if crc32.Checksum(id[:], castagnoliTable) != binary.BigEndian.Uint32(buf[byteLength:]) {
+		return Nil, ErrChecksumMismatch
+	}
+	return id, nil
+}
+
+func encodeBase62Checked(buf [checkedByteLength]byte) string {
+	n := new(big.Int).SetBytes(buf[:])
+	base := big.NewInt(62)
+	mod := new(big.Int)
+
+	dst := make([]byte, checkedStringEncodedLength)
+	for i := len(dst) - 1; i >= 0; i-- {
+		if n.Sign() == 0 {
+			dst[i] = base62Alphabet[0]
+			continue
+		}
+		n.DivMod(n, base, mod)
+		dst[i] = base62Alphabet[mod.Int64()]
+	}
+	return string(dst)
+}
+
+func decodeBase62Checked(s string) ([checkedByteLength]byte, error) {
+	var buf [checkedByteLength]byte
+	if len(s) != checkedStringEncodedLength {
+		return buf, fmt.Errorf("ksuid: valid checked KSUIDs are %v characters", checkedStringEncodedLength)
+	}
+
+	n := new(big.Int)
+	base := big.NewInt(62)
+	for _, c := range []byte(s) {
+		d := base62Index(c)
+		if d < 0 {
+			return buf, fmt.Errorf("ksuid: invalid character %q in checked KSUID", c)
+		}
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(d)))
+	}
+
+	b := n.Bytes()
+	if len(b) > checkedByteLength {
+		return buf, fmt.Errorf("ksuid: checked KSUID value out of range")
+	}
+	copy(buf[checkedByteLength-len(b):], b)
+	return buf, nil
+}
+
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+func base62Index(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + 36
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,38 @@
+package ksuid
+
+import "testing"
+
+func TestStringCheckedRoundTrip(t *testing.T) {
+	id := New()
+
+	s := id.StringChecked()
+	if len(s) != checkedStringEncodedLength {
+		t.Fatalf("len(StringChecked()) = %d, want %d", len(s), checkedStringEncodedLength)
+	}
+
+	got, err := ParseChecked(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != id {
+		t.Fatalf("ParseChecked() = %s, want %s", got, id)
+	}
+}
+
+func TestParseCheckedDetectsCorruption(t *testing.T) {
+	s := New().StringChecked()
+
+	corrupted := []byte(s)
+	corrupted[0] = flipBase62Char(corrupted[0])
+
+	if _, err := ParseChecked(string(corrupted)); err != ErrChecksumMismatch {
+		t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+	}
+}
+
+func flipBase62Char(c byte) byte {
+	if c != base62Alphabet[0] {
+		return base62Alphabet[0]
+	}
+	return base62Alphabet[1]
+}
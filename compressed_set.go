@@ -0,0 +1,78 @@
+package ksuid
+
+type CompressedSet []byte
+
+func Compress(ids ...KSUID) CompressedSet {
+	return AppendCompressed(nil, ids...)
+}
+
+func AppendCompressed(b []byte, ids ...KSUID) []byte {
+	if len(ids) == 0 {
+		return b
+	}
+
+	sorted := make([]KSUID, len(ids))
+	copy(sorted, ids)
+	Sort(sorted)
+
+	var prev KSUID
+	for i, id := range sorted {
+		prefixLen := 0
+		if i != 0 {
+			prefixLen = commonPrefixLen(prev, id)
+		}
+		b = append(b, byte(prefixLen))
+		b = append(b, id[prefixLen:]...)
+		prev = id
+	}
+	return b
+}
+
+func (set CompressedSet) Len() int {
+	n := 0
+	for it := set.Iter(); it.Next(); {
+		n++
+	}
+	return n
+}
+
+func (set CompressedSet) Iter() CompressedSetIter {
+	return CompressedSetIter{content: []byte(set)}
+}
+
+type CompressedSetIter struct {
+	content []byte
+	prev    KSUID
+	KSUID   KSUID
+}
+
+func (it *CompressedSetIter) Next() bool {
+	if len(it.content) < 1 {
+		return false
+	}
+
+	prefixLen := int(it.content[0])
+	suffixLen := byteLength - prefixLen
+	it.content = it.content[1:]
+
+	if prefixLen > byteLength || suffixLen > len(it.content) {
+		return false
+	}
+
+	var id KSUID
+	copy(id[:prefixLen], it.prev[:prefixLen])
+	copy(id[prefixLen:], it.content[:suffixLen])
+	it.content = it.content[suffixLen:]
+
+	it.KSUID = id
+	it.prev = id
+	return true
+}
+
+func commonPrefixLen(a, b KSUID) int {
+	n := 0
+	for n < byteLength && a[n] == b[n] {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,34 @@
+package ksuid
+
+import "testing"
+
+func TestCompressedSetRoundTrip(t *testing.T) {
+	ids := []KSUID{New(), New(), New(), New()}
+
+	set := Compress(ids...)
+
+	if n := set.Len(); n != len(ids) {
+		t.Fatalf("Len() = %d, want %d", n, len(ids))
+	}
+
+	sorted := make([]KSUID, len(ids))
+	copy(sorted, ids)
+	Sort(sorted)
+
+	i := 0
+	for it := set.Iter(); it.Next(); i++ {
+		if it.KSUID != sorted[i] {
+			t.Fatalf("entry %d = %s, want %s", i, it.KSUID, sorted[i])
+		}
+	}
+	if i != len(sorted) {
+		t.Fatalf("decoded %d entries, want %d", i, len(sorted))
+	}
+}
+
+func TestCompressedSetEmpty(t *testing.T) {
+	set := Compress()
+	if n := set.Len(); n != 0 {
+		t.Fatalf("Len() = %d, want 0", n)
+	}
+}
@@ -0,0 +1,73 @@
+package ksuid
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// FastRander is an io.Reader backed by a per-goroutine math/rand source
+// pulled from a sync.Pool, trading crypto/rand's unpredictability for
+// throughput. Use it through New or NewRandomWith, not SetRand: SetRand
+// only swaps the reader NewRandomWithTime pulls from, and NewRandomWithTime
+// still takes randMutex around that read, so routing FastRander through
+// SetRand serializes callers just the same.
+type FastRander struct {
+	pool sync.Pool
+}
+
+// NewFastRander returns a ready to use FastRander.
+func NewFastRander() *FastRander {
+	return &FastRander{
+		pool: sync.Pool{
+			New: func() any {
+				return rand.New(rand.NewSource(cryptoSeed()))
+			},
+		},
+	}
+}
+
+// cryptoSeed returns a crypto/rand-sourced seed so concurrent goroutines
+// populating the pool at the same instant don't land on identical
+// math/rand streams, which time.Now().UnixNano() alone cannot guarantee.
+func cryptoSeed() int64 {
+	var b [8]byte
+	if _, err := cryptorand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+	return int64(binary.BigEndian.Uint64(b[:]))
+}
+
+// Read implements io.Reader, drawing from a per-goroutine *rand.Rand.
+func (f *FastRander) Read(p []byte) (int, error) {
+	r := f.pool.Get().(*rand.Rand)
+	n, err := r.Read(p)
+	f.pool.Put(r)
+	return n, err
+}
+
+// New mints a KSUID stamped with the current time, reading its payload
+// directly from f. Unlike SetRand(f) followed by NewRandom, this bypasses
+// randMutex entirely.
+func (f *FastRander) New() (KSUID, error) {
+	return NewRandomWith(f)
+}
+
+// NewRandomWith mints a KSUID stamped with the current time, reading its
+// payload from r instead of the reader installed by SetRand, and without
+// taking randMutex.
+func NewRandomWith(r io.Reader) (KSUID, error) {
+	var id KSUID
+	var buf [payloadLengthInBytes]byte
+
+	if _, err := io.ReadAtLeast(r, buf[:], len(buf)); err != nil {
+		return Nil, err
+	}
+
+	copy(id[timestampLengthInBytes:], buf[:])
+	binary.BigEndian.PutUint32(id[:timestampLengthInBytes], timeToCorrectedUTCTimestamp(time.Now()))
+	return id, nil
+}
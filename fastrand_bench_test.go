@@ -0,0 +1,28 @@
+package ksuid
+
+import (
+	"testing"
+	"time"
+)
+
+func BenchmarkNewRandomWithTime(b *testing.B) {
+	now := time.Now()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := NewRandomWithTime(now); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkFastRanderNew(b *testing.B) {
+	f := NewFastRander()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := f.New(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
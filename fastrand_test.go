@@ -0,0 +1,47 @@
+package ksuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestFastRanderConcurrent(t *testing.T) {
+	f := NewFastRander()
+
+	var wg sync.WaitGroup
+	ids := make([]KSUID, 100)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id, err := f.New()
+			if err != nil {
+				t.Errorf("New: %v", err)
+				return
+			}
+			ids[i] = id
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[KSUID]bool, len(ids))
+	for _, id := range ids {
+		if id.IsNil() {
+			t.Fatal("got nil KSUID")
+		}
+		if seen[id] {
+			t.Fatalf("duplicate KSUID: %s", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestNewRandomWith(t *testing.T) {
+	id, err := NewRandomWith(NewFastRander())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.IsNil() {
+		t.Fatal("got nil KSUID")
+	}
+}
@@ -0,0 +1,30 @@
+// Package ksuidpb provides a thin protobuf-compatible wrapper around
+// ksuid.KSUID so IDs can be carried in gRPC messages through a `bytes`
+// field without hand-rolled conversion at each call site.
+package ksuidpb
+
+import "github.com/inovacc/ksuid"
+
+// KSUID wraps a ksuid.KSUID for embedding in generated protobuf messages
+// that declare the corresponding field as `bytes`.
+type KSUID struct {
+	ksuid.KSUID
+}
+
+// Marshal encodes k as its raw 20-byte representation, compatible with a
+// protobuf `bytes` field. It round-trips ksuid.Nil.
+func (k KSUID) Marshal() ([]byte, error) {
+	b := make([]byte, len(k.KSUID))
+	copy(b, k.KSUID.Bytes())
+	return b, nil
+}
+
+// Unmarshal decodes b, as produced by Marshal, into k.
+func (k *KSUID) Unmarshal(b []byte) error {
+	id, err := ksuid.FromBytes(b)
+	if err != nil {
+		return err
+	}
+	k.KSUID = id
+	return nil
+}
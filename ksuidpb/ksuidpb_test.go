@@ -0,0 +1,26 @@
+package ksuidpb
+
+import (
+	"testing"
+
+	"github.com/inovacc/ksuid"
+)
+
+func TestMarshalUnmarshalRoundTrip(t *testing.T) {
+	for _, id := range []ksuid.KSUID{ksuid.New(), ksuid.Nil} {
+		k := KSUID{KSUID: id}
+
+		b, err := k.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got KSUID
+		if err := got.Unmarshal(b); err != nil {
+			t.Fatal(err)
+		}
+		if got.KSUID != id {
+			t.Fatalf("Unmarshal() = %s, want %s", got.KSUID, id)
+		}
+	}
+}
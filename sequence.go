@@ -0,0 +1,33 @@
+package ksuid
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const maxSequenceCount = 65536
+
+var ErrSequenceExhausted = fmt.Errorf("sequence exhausted: a maximum of %d KSUIDs can be generated from a single seed", maxSequenceCount)
+
+type Sequence struct {
+	Seed KSUID
+
+	count uint32
+}
+
+func (s *Sequence) Next() (KSUID, error) {
+	if s.count >= maxSequenceCount {
+		return Nil, ErrSequenceExhausted
+	}
+	id := s.Seed
+	binary.BigEndian.PutUint16(id[byteLength-2:], uint16(s.count))
+	s.count++
+	return id, nil
+}
+
+func (s *Sequence) Bounds() (min, max KSUID) {
+	min, max = s.Seed, s.Seed
+	binary.BigEndian.PutUint16(min[byteLength-2:], 0)
+	binary.BigEndian.PutUint16(max[byteLength-2:], maxSequenceCount-1)
+	return
+}
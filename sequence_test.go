@@ -0,0 +1,37 @@
+package ksuid
+
+import "testing"
+
+func TestSequence(t *testing.T) {
+	seed := New()
+	seq := Sequence{Seed: seed}
+
+	min, max := seq.Bounds()
+
+	prev, err := seq.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prev != min {
+		t.Fatalf("first id %s does not match lower bound %s", prev, min)
+	}
+
+	for i := 1; i < maxSequenceCount; i++ {
+		id, err := seq.Next()
+		if err != nil {
+			t.Fatalf("Next() at i=%d: %v", i, err)
+		}
+		if Compare(prev, id) >= 0 {
+			t.Fatalf("Next() did not increase: %s >= %s", prev, id)
+		}
+		prev = id
+	}
+
+	if prev != max {
+		t.Fatalf("last id %s does not match upper bound %s", prev, max)
+	}
+
+	if _, err := seq.Next(); err != ErrSequenceExhausted {
+		t.Fatalf("expected ErrSequenceExhausted, got %v", err)
+	}
+}
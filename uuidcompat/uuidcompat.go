@@ -0,0 +1,52 @@
+// Package uuidcompat bridges github.com/google/uuid and ksuid, so a project
+// can migrate from UUID-typed columns to KSUIDs incrementally rather than in
+// one big rewrite.
+package uuidcompat
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/inovacc/ksuid"
+)
+
+// FromUUID packs u's 16 bytes as a KSUID payload. It stamps the result with
+// the current time, or with at[0] if a time is supplied, so callers that
+// already know a UUID's creation time can preserve it.
+func FromUUID(u uuid.UUID, at ...time.Time) ksuid.KSUID {
+	t := time.Now()
+	if len(at) > 0 {
+		t = at[0]
+	}
+	return ksuid.FromPartsOrNil(t, u[:])
+}
+
+// ToUUID returns k's 16-byte payload as a UUID, discarding the KSUID's
+// 4-byte timestamp.
+func ToUUID(k ksuid.KSUID) uuid.UUID {
+	var u uuid.UUID
+	copy(u[:], k.Payload())
+	return u
+}
+
+// NewV7Compatible mints a KSUID whose payload starts with the same 48-bit
+// unix-millisecond timestamp UUIDv7 carries in its leading bytes. Systems
+// that sort on a UUIDv7 prefix keep ordering correctly when fed ToUUID(id),
+// while the KSUID itself retains its own second-precision timestamp and
+// full 20-byte lexicographic ordering.
+func NewV7Compatible() (ksuid.KSUID, error) {
+	var payload [16]byte
+
+	var ms [8]byte
+	binary.BigEndian.PutUint64(ms[:], uint64(time.Now().UnixMilli()))
+	copy(payload[:6], ms[2:])
+
+	if _, err := rand.Read(payload[6:]); err != nil {
+		return ksuid.Nil, err
+	}
+
+	return ksuid.FromParts(time.Now(), payload[:])
+}
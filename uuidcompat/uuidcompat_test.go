@@ -0,0 +1,38 @@
+package uuidcompat
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestFromUUIDToUUIDRoundTrip(t *testing.T) {
+	u := uuid.New()
+
+	id := FromUUID(u)
+
+	if got := ToUUID(id); got != u {
+		t.Fatalf("ToUUID(FromUUID(u)) = %s, want %s", got, u)
+	}
+}
+
+func TestNewV7CompatibleOrdering(t *testing.T) {
+	first, err := NewV7Compatible()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(2 * time.Millisecond)
+
+	second, err := NewV7Compatible()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, b := ToUUID(first), ToUUID(second)
+	if bytes.Compare(a[:6], b[:6]) >= 0 {
+		t.Fatalf("leading 6 bytes not increasing: %x >= %x", a[:6], b[:6])
+	}
+}